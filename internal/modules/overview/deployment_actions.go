@@ -0,0 +1,268 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package overview
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware/octant/internal/modules/overview/printer"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+)
+
+// deploymentPauseHandler pauses a Deployment's rollout, matching
+// `kubectl rollout pause`.
+type deploymentPauseHandler struct {
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*deploymentPauseHandler)(nil)
+
+func newDeploymentPauseHandler(objectStore store.Store) *deploymentPauseHandler {
+	return &deploymentPauseHandler{objectStore: objectStore}
+}
+
+func (h *deploymentPauseHandler) ActionName() string {
+	return "deployment/pause"
+}
+
+func (h *deploymentPauseHandler) Handle(ctx context.Context, request *action.Request) error {
+	return setDeploymentPaused(ctx, h.objectStore, request, true)
+}
+
+// deploymentResumeHandler resumes a paused Deployment's rollout, matching
+// `kubectl rollout resume`.
+type deploymentResumeHandler struct {
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*deploymentResumeHandler)(nil)
+
+func newDeploymentResumeHandler(objectStore store.Store) *deploymentResumeHandler {
+	return &deploymentResumeHandler{objectStore: objectStore}
+}
+
+func (h *deploymentResumeHandler) ActionName() string {
+	return "deployment/resume"
+}
+
+func (h *deploymentResumeHandler) Handle(ctx context.Context, request *action.Request) error {
+	return setDeploymentPaused(ctx, h.objectStore, request, false)
+}
+
+func setDeploymentPaused(ctx context.Context, objectStore store.Store, request *action.Request, paused bool) error {
+	key, err := deploymentKeyFromRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return objectStore.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, paused, "spec", "paused")
+	})
+}
+
+// deploymentRestartHandler restarts a Deployment's rollout by stamping the
+// pod template with a restartedAt annotation, matching
+// `kubectl rollout restart`.
+type deploymentRestartHandler struct {
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*deploymentRestartHandler)(nil)
+
+func newDeploymentRestartHandler(objectStore store.Store) *deploymentRestartHandler {
+	return &deploymentRestartHandler{objectStore: objectStore}
+}
+
+func (h *deploymentRestartHandler) ActionName() string {
+	return "deployment/restart"
+}
+
+func (h *deploymentRestartHandler) Handle(ctx context.Context, request *action.Request) error {
+	key, err := deploymentKeyFromRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return h.objectStore.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(object.Object, time.Now().Format(time.RFC3339),
+			"spec", "template", "metadata", "annotations", "kubectl.kubernetes.io/restartedAt")
+	})
+}
+
+// deploymentRollbackHandler rolls a Deployment back to a prior revision by
+// copying the pod template from the selected ReplicaSet, matching
+// `kubectl rollout undo --to-revision`.
+type deploymentRollbackHandler struct {
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*deploymentRollbackHandler)(nil)
+
+func newDeploymentRollbackHandler(objectStore store.Store) *deploymentRollbackHandler {
+	return &deploymentRollbackHandler{objectStore: objectStore}
+}
+
+func (h *deploymentRollbackHandler) ActionName() string {
+	return "deployment/rollback"
+}
+
+func (h *deploymentRollbackHandler) Handle(ctx context.Context, request *action.Request) error {
+	revision, err := request.Payload.String("revision")
+	if err != nil {
+		return errors.Wrap(err, "get revision from payload")
+	}
+
+	key, err := deploymentKeyFromRequest(request)
+	if err != nil {
+		return err
+	}
+
+	object, err := h.objectStore.Get(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "get deployment")
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, deployment); err != nil {
+		return errors.Wrap(err, "convert deployment")
+	}
+
+	replicaSets, err := printer.ControlledDeploymentReplicaSets(ctx, deployment, h.objectStore)
+	if err != nil {
+		return err
+	}
+
+	var template map[string]interface{}
+	for _, rs := range replicaSets {
+		if rs.Annotations["deployment.kubernetes.io/revision"] != revision {
+			continue
+		}
+
+		rsObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&rs.Spec.Template)
+		if err != nil {
+			return errors.Wrap(err, "convert pod template")
+		}
+		template = rsObject
+		break
+	}
+	if template == nil {
+		return errors.Errorf("no replica set found for revision %s", revision)
+	}
+
+	return h.objectStore.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		return unstructured.SetNestedMap(object.Object, template, "spec", "template")
+	})
+}
+
+// deploymentConfigurationHandler applies the replica count and rollout
+// strategy edited from the Deployment's configuration form.
+type deploymentConfigurationHandler struct {
+	objectStore store.Store
+}
+
+var _ action.Dispatcher = (*deploymentConfigurationHandler)(nil)
+
+func newDeploymentConfigurationHandler(objectStore store.Store) *deploymentConfigurationHandler {
+	return &deploymentConfigurationHandler{objectStore: objectStore}
+}
+
+func (h *deploymentConfigurationHandler) ActionName() string {
+	return "deployment/configuration"
+}
+
+func (h *deploymentConfigurationHandler) Handle(ctx context.Context, request *action.Request) error {
+	key, err := deploymentKeyFromRequest(request)
+	if err != nil {
+		return err
+	}
+
+	replicas, err := request.Payload.Int64("replicas")
+	if err != nil {
+		return errors.Wrap(err, "get replicas from payload")
+	}
+
+	strategyType, err := request.Payload.String("strategyType")
+	if err != nil {
+		return errors.Wrap(err, "get strategyType from payload")
+	}
+
+	var rollingUpdate map[string]interface{}
+	if appsv1.DeploymentStrategyType(strategyType) == appsv1.RollingUpdateDeploymentStrategyType {
+		maxSurge, err := request.Payload.String("maxSurge")
+		if err != nil {
+			return errors.Wrap(err, "get maxSurge from payload")
+		}
+
+		maxUnavailable, err := request.Payload.String("maxUnavailable")
+		if err != nil {
+			return errors.Wrap(err, "get maxUnavailable from payload")
+		}
+
+		if isZeroIntOrString(maxSurge, int(replicas)) && isZeroIntOrString(maxUnavailable, int(replicas)) {
+			return errors.New("maxSurge and maxUnavailable cannot both be zero")
+		}
+
+		rollingUpdate = map[string]interface{}{
+			"maxSurge":       maxSurge,
+			"maxUnavailable": maxUnavailable,
+		}
+	}
+
+	return h.objectStore.Update(ctx, key, func(object *unstructured.Unstructured) error {
+		if err := unstructured.SetNestedField(object.Object, replicas, "spec", "replicas"); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(object.Object, strategyType, "spec", "strategy", "type"); err != nil {
+			return err
+		}
+		if rollingUpdate != nil {
+			return unstructured.SetNestedMap(object.Object, rollingUpdate, "spec", "strategy", "rollingUpdate")
+		}
+		unstructured.RemoveNestedField(object.Object, "spec", "strategy", "rollingUpdate")
+		return nil
+	})
+}
+
+// isZeroIntOrString reports whether an IntOrString value (an int or a
+// percentage, e.g. "25%") scales to zero against the given total replica
+// count. Percentages must be resolved against total -- intstr.IntValue()
+// always returns 0 for a String-typed value regardless of its content, so
+// it cannot be used to detect a zero percentage on its own.
+func isZeroIntOrString(s string, total int) bool {
+	v := intstr.Parse(s)
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(&v, total, true)
+	if err != nil {
+		return false
+	}
+	return scaled == 0
+}
+
+func deploymentKeyFromRequest(request *action.Request) (store.Key, error) {
+	namespace, err := request.Payload.String("namespace")
+	if err != nil {
+		return store.Key{}, errors.Wrap(err, "get namespace from payload")
+	}
+
+	name, err := request.Payload.String("name")
+	if err != nil {
+		return store.Key{}, errors.Wrap(err, "get name from payload")
+	}
+
+	return store.Key{
+		Namespace:  namespace,
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       name,
+	}, nil
+}
@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package overview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/action"
+	"github.com/vmware/octant/pkg/store"
+	storefake "github.com/vmware/octant/pkg/store/fake"
+)
+
+func deploymentKey(deployment *appsv1.Deployment) store.Key {
+	return store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       deployment.Name,
+	}
+}
+
+func Test_deploymentPauseHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	key := deploymentKey(deployment)
+	object := testutil.ToUnstructured(t, deployment)
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, fn func(*unstructured.Unstructured) error) error {
+			return fn(object)
+		})
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace": deployment.Namespace,
+		"name":      deployment.Name,
+	}}
+
+	handler := newDeploymentPauseHandler(objectStore)
+	require.Equal(t, "deployment/pause", handler.ActionName())
+
+	err := handler.Handle(context.Background(), request)
+	require.NoError(t, err)
+
+	paused, _, err := unstructured.NestedBool(object.Object, "spec", "paused")
+	require.NoError(t, err)
+	assert.True(t, paused)
+}
+
+func Test_deploymentResumeHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	key := deploymentKey(deployment)
+	object := testutil.ToUnstructured(t, deployment)
+	require.NoError(t, unstructured.SetNestedField(object.Object, true, "spec", "paused"))
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, fn func(*unstructured.Unstructured) error) error {
+			return fn(object)
+		})
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace": deployment.Namespace,
+		"name":      deployment.Name,
+	}}
+
+	handler := newDeploymentResumeHandler(objectStore)
+	require.Equal(t, "deployment/resume", handler.ActionName())
+
+	err := handler.Handle(context.Background(), request)
+	require.NoError(t, err)
+
+	paused, _, err := unstructured.NestedBool(object.Object, "spec", "paused")
+	require.NoError(t, err)
+	assert.False(t, paused)
+}
+
+func Test_deploymentRestartHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	key := deploymentKey(deployment)
+	object := testutil.ToUnstructured(t, deployment)
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, fn func(*unstructured.Unstructured) error) error {
+			return fn(object)
+		})
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace": deployment.Namespace,
+		"name":      deployment.Name,
+	}}
+
+	handler := newDeploymentRestartHandler(objectStore)
+	require.Equal(t, "deployment/restart", handler.ActionName())
+
+	err := handler.Handle(context.Background(), request)
+	require.NoError(t, err)
+
+	restartedAt, found, err := unstructured.NestedString(object.Object,
+		"spec", "template", "metadata", "annotations", "kubectl.kubernetes.io/restartedAt")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.NotEmpty(t, restartedAt)
+}
+
+func Test_deploymentRollbackHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = "deployment-uid"
+	key := deploymentKey(deployment)
+	object := testutil.ToUnstructured(t, deployment)
+
+	rs := testutil.CreateReplicaSet("deployment-abc123")
+	rs.OwnerReferences = []metav1.OwnerReference{{UID: deployment.UID}}
+	rs.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	rs.Spec.Template.Spec.Containers = []corev1.Container{{Name: "app", Image: "old-image"}}
+
+	var rsSelector labels.Set
+	rsKey := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &rsSelector,
+	}
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), rsKey).
+		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, rs)}, nil)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, fn func(*unstructured.Unstructured) error) error {
+			return fn(object)
+		})
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace": deployment.Namespace,
+		"name":      deployment.Name,
+		"revision":  "1",
+	}}
+
+	handler := newDeploymentRollbackHandler(objectStore)
+	require.Equal(t, "deployment/rollback", handler.ActionName())
+
+	err := handler.Handle(context.Background(), request)
+	require.NoError(t, err)
+
+	containers, found, err := unstructured.NestedSlice(object.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Len(t, containers, 1)
+}
+
+func Test_deploymentRollbackHandler_revisionNotFound(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = "deployment-uid"
+	key := deploymentKey(deployment)
+	object := testutil.ToUnstructured(t, deployment)
+
+	var rsSelector labels.Set
+	rsKey := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &rsSelector,
+	}
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil)
+	objectStore.EXPECT().List(gomock.Any(), rsKey).Return(nil, nil)
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace": deployment.Namespace,
+		"name":      deployment.Name,
+		"revision":  "1",
+	}}
+
+	handler := newDeploymentRollbackHandler(objectStore)
+	err := handler.Handle(context.Background(), request)
+	assert.Error(t, err)
+}
+
+func Test_deploymentConfigurationHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+	key := deploymentKey(deployment)
+	object := testutil.ToUnstructured(t, deployment)
+
+	objectStore := storefake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, fn func(*unstructured.Unstructured) error) error {
+			return fn(object)
+		})
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace":      deployment.Namespace,
+		"name":           deployment.Name,
+		"replicas":       int64(5),
+		"strategyType":   string(appsv1.RollingUpdateDeploymentStrategyType),
+		"maxSurge":       "25%",
+		"maxUnavailable": "25%",
+	}}
+
+	handler := newDeploymentConfigurationHandler(objectStore)
+	require.Equal(t, "deployment/configuration", handler.ActionName())
+
+	err := handler.Handle(context.Background(), request)
+	require.NoError(t, err)
+
+	replicas, _, err := unstructured.NestedInt64(object.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), replicas)
+}
+
+func Test_deploymentConfigurationHandler_zeroMaxSurgeAndMaxUnavailable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	deployment := testutil.CreateDeployment("deployment")
+
+	objectStore := storefake.NewMockStore(controller)
+
+	request := &action.Request{Payload: action.Payload{
+		"namespace":      deployment.Namespace,
+		"name":           deployment.Name,
+		"replicas":       int64(4),
+		"strategyType":   string(appsv1.RollingUpdateDeploymentStrategyType),
+		"maxSurge":       "0%",
+		"maxUnavailable": "0%",
+	}}
+
+	handler := newDeploymentConfigurationHandler(objectStore)
+	err := handler.Handle(context.Background(), request)
+	assert.Error(t, err)
+}
+
+func Test_isZeroIntOrString(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		total int
+		want  bool
+	}{
+		{name: "zero int", value: "0", total: 4, want: true},
+		{name: "zero percent", value: "0%", total: 4, want: true},
+		{name: "non-zero int", value: "1", total: 4, want: false},
+		{name: "non-zero percent that rounds up", value: "1%", total: 4, want: false},
+		{name: "non-zero percent", value: "25%", total: 4, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := isZeroIntOrString(test.value, test.total)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
@@ -0,0 +1,715 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// DeploymentListHandler prints a list of deployments
+func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opts Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("nil list")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Status", "Age", "Containers", "Selector")
+	table := component.NewTable("Deployments", cols)
+
+	for i := range list.Items {
+		d := list.Items[i]
+
+		row := component.TableRow{}
+
+		nameLink, err := opts.Link.ForObject(&d, d.Name)
+		if err != nil {
+			return nil, err
+		}
+		row["Name"] = nameLink
+
+		row["Labels"] = component.NewLabels(d.Labels)
+
+		status := fmt.Sprintf("%d/%d", d.Status.AvailableReplicas, d.Status.Replicas)
+		row["Status"] = component.NewText(status)
+
+		row["Age"] = component.NewTimestamp(d.CreationTimestamp.Time)
+
+		containers := component.NewContainers()
+		for _, c := range d.Spec.Template.Spec.Containers {
+			containers.Add(c.Name, c.Image)
+		}
+		row["Containers"] = containers
+
+		row["Selector"] = printDeploymentSelector(d.Spec.Selector)
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+func printDeploymentSelector(selector *metav1.LabelSelector) *component.Selectors {
+	var selectors []component.Selector
+
+	if selector != nil {
+		for _, lsr := range selector.MatchExpressions {
+			op, err := component.MatchOperator(string(lsr.Operator))
+			if err != nil {
+				continue
+			}
+			selectors = append(selectors, component.NewExpressionSelector(lsr.Key, op, lsr.Values))
+		}
+
+		for k, v := range selector.MatchLabels {
+			selectors = append(selectors, component.NewLabelSelector(k, v))
+		}
+	}
+
+	return component.NewSelectors(selectors)
+}
+
+// actionGeneratorFunction generates actions for a deployment's configuration summary.
+type actionGeneratorFunction func(d *appsv1.Deployment) []component.Action
+
+// DeploymentConfiguration generates a configuration summary for a deployment.
+type DeploymentConfiguration struct {
+	ctx              context.Context
+	deployment       *appsv1.Deployment
+	objectStore      store.Store
+	actionGenerators []actionGeneratorFunction
+}
+
+// NewDeploymentConfiguration creates an instance of DeploymentConfiguration.
+// objectStore is used to look up the Deployment's controlled ReplicaSets to
+// populate the "Rollback to revision..." action; it may be nil to omit that
+// action.
+func NewDeploymentConfiguration(ctx context.Context, d *appsv1.Deployment, objectStore store.Store) *DeploymentConfiguration {
+	return &DeploymentConfiguration{
+		ctx:         ctx,
+		deployment:  d,
+		objectStore: objectStore,
+		actionGenerators: []actionGeneratorFunction{
+			editDeploymentAction,
+			pauseResumeDeploymentAction,
+			restartDeploymentAction,
+		},
+	}
+}
+
+// Create generates a deployment configuration summary.
+func (dc *DeploymentConfiguration) Create() (*component.Summary, error) {
+	if dc == nil || dc.deployment == nil {
+		return nil, errors.New("deployment is nil")
+	}
+	d := dc.deployment
+
+	var sections []component.SummarySection
+
+	sections = append(sections, component.SummarySection{
+		Header:  "Deployment Strategy",
+		Content: component.NewText(string(d.Spec.Strategy.Type)),
+	})
+
+	if d.Spec.Strategy.Type == appsv1.RollingUpdateDeploymentStrategyType && d.Spec.Strategy.RollingUpdate != nil {
+		ru := d.Spec.Strategy.RollingUpdate
+		text := fmt.Sprintf("Max Surge %s, Max Unavailable %s",
+			ru.MaxSurge.String(), ru.MaxUnavailable.String())
+		sections = append(sections, component.SummarySection{
+			Header:  "Rolling Update Strategy",
+			Content: component.NewText(text),
+		})
+	}
+
+	sections = append(sections, component.SummarySection{
+		Header:  "Selectors",
+		Content: printDeploymentSelector(d.Spec.Selector),
+	})
+
+	sections = append(sections, component.SummarySection{
+		Header:  "Min Ready Seconds",
+		Content: component.NewText(fmt.Sprintf("%d", d.Spec.MinReadySeconds)),
+	})
+
+	if d.Spec.RevisionHistoryLimit != nil {
+		sections = append(sections, component.SummarySection{
+			Header:  "Revision History Limit",
+			Content: component.NewText(fmt.Sprintf("%d", *d.Spec.RevisionHistoryLimit)),
+		})
+	}
+
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	sections = append(sections, component.SummarySection{
+		Header:  "Replicas",
+		Content: component.NewText(fmt.Sprintf("%d", replicas)),
+	})
+
+	rolloutStatusLayout, err := DeploymentRolloutStatus(d)
+	if err != nil {
+		return nil, err
+	}
+	sections = append(sections, component.SummarySection{
+		Header:  "Rollout Status",
+		Content: rolloutStatusLayout,
+	})
+
+	if dc.objectStore != nil {
+		history, err := revisionHistory(dc.ctx, d, dc.objectStore)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, component.SummarySection{
+			Header:  "Revision History",
+			Content: history,
+		})
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+
+	var actions []component.Action
+	for _, ag := range dc.actionGenerators {
+		actions = append(actions, ag(d)...)
+	}
+
+	if dc.objectStore != nil {
+		rollback, err := RollbackAction(dc.ctx, d, dc.objectStore)
+		if err != nil {
+			return nil, err
+		}
+		if rollback != nil {
+			actions = append(actions, *rollback)
+		}
+	}
+
+	if len(actions) > 0 {
+		summary.AddAction(actions...)
+	}
+
+	return summary, nil
+}
+
+// DeploymentStatus generates a status quadrant for a deployment.
+type DeploymentStatus struct {
+	deployment *appsv1.Deployment
+}
+
+// NewDeploymentStatus creates an instance of DeploymentStatus.
+func NewDeploymentStatus(d *appsv1.Deployment) *DeploymentStatus {
+	return &DeploymentStatus{
+		deployment: d,
+	}
+}
+
+// Create generates a deployment status quadrant.
+func (ds *DeploymentStatus) Create() (*component.Quadrant, error) {
+	if ds == nil || ds.deployment == nil {
+		return nil, errors.New("deployment is nil")
+	}
+
+	status := ds.deployment.Status
+
+	quadrant := component.NewQuadrant("Status")
+	if err := quadrant.Set(component.QuadNW, "Updated", fmt.Sprintf("%d", status.UpdatedReplicas)); err != nil {
+		return nil, errors.Wrap(err, "set updated status")
+	}
+	if err := quadrant.Set(component.QuadNE, "Total", fmt.Sprintf("%d", status.Replicas)); err != nil {
+		return nil, errors.Wrap(err, "set total status")
+	}
+	if err := quadrant.Set(component.QuadSW, "Unavailable", fmt.Sprintf("%d", status.UnavailableReplicas)); err != nil {
+		return nil, errors.Wrap(err, "set unavailable status")
+	}
+	if err := quadrant.Set(component.QuadSE, "Available", fmt.Sprintf("%d", status.AvailableReplicas)); err != nil {
+		return nil, errors.Wrap(err, "set available status")
+	}
+
+	return quadrant, nil
+}
+
+// rolloutStatus computes a human readable rollout condition for a deployment,
+// mirroring the logic `kubectl rollout status` derives from a Deployment's
+// observed status and conditions.
+func rolloutStatus(d *appsv1.Deployment) string {
+	if d == nil {
+		return ""
+	}
+
+	if d.Generation > d.Status.ObservedGeneration {
+		return "Waiting for deployment spec update to be observed"
+	}
+
+	for _, c := range d.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+			return fmt.Sprintf("deployment %q exceeded its progress deadline", d.Name)
+		}
+	}
+
+	desired := int32(1)
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
+
+	if d.Status.UpdatedReplicas < desired {
+		return fmt.Sprintf("waiting for rollout to finish: %d of %d new replicas have been updated",
+			d.Status.UpdatedReplicas, desired)
+	}
+
+	if d.Status.Replicas > d.Status.UpdatedReplicas {
+		return fmt.Sprintf("waiting for rollout to finish: %d old replicas are pending termination",
+			d.Status.Replicas-d.Status.UpdatedReplicas)
+	}
+
+	if d.Status.AvailableReplicas < d.Status.UpdatedReplicas {
+		return fmt.Sprintf("waiting for rollout to finish: %d of %d updated replicas are available",
+			d.Status.AvailableReplicas, d.Status.UpdatedReplicas)
+	}
+
+	return fmt.Sprintf("deployment %q successfully rolled out", d.Name)
+}
+
+// deploymentConditions renders a Deployment's observed DeploymentConditions
+// (Available/Progressing/ReplicaFailure) as a table, matching the
+// information `kubectl describe deployment` shows under "Conditions".
+func deploymentConditions(d *appsv1.Deployment) *component.Table {
+	cols := component.NewTableCols("Type", "Status", "Reason", "Message", "Last Transition")
+	table := component.NewTable("Conditions", cols)
+
+	for _, c := range d.Status.Conditions {
+		table.Add(component.TableRow{
+			"Type":            component.NewText(string(c.Type)),
+			"Status":          component.NewText(string(c.Status)),
+			"Reason":          component.NewText(c.Reason),
+			"Message":         component.NewText(c.Message),
+			"Last Transition": component.NewTimestamp(c.LastTransitionTime.Time),
+		})
+	}
+
+	return table
+}
+
+// DeploymentRolloutStatus generates a combined view of the computed rollout
+// condition, the deployment's progress deadline, and its observed
+// conditions -- the information `kubectl rollout status` and
+// `kubectl describe deployment` surface for an in-progress or completed
+// rollout.
+func DeploymentRolloutStatus(d *appsv1.Deployment) (*component.FlexLayout, error) {
+	if d == nil {
+		return nil, errors.New("deployment is nil")
+	}
+
+	layout := component.NewFlexLayout("Rollout Status")
+
+	var sections []component.SummarySection
+
+	sections = append(sections, component.SummarySection{
+		Header:  "Status",
+		Content: component.NewText(rolloutStatus(d)),
+	})
+
+	if d.Spec.ProgressDeadlineSeconds != nil {
+		sections = append(sections, component.SummarySection{
+			Header:  "Progress Deadline Seconds",
+			Content: component.NewText(fmt.Sprintf("%d", *d.Spec.ProgressDeadlineSeconds)),
+		})
+	}
+
+	summary := component.NewSummary("Rollout Status", sections...)
+
+	layout.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthHalf, View: summary},
+		{Width: component.WidthHalf, View: deploymentConditions(d)},
+	})
+
+	return layout, nil
+}
+
+// revisionHistory returns a FlexLayout summarizing the ReplicaSets owned by
+// this Deployment, ordered by the `deployment.kubernetes.io/revision`
+// annotation, so a user can see the current revision alongside prior ones
+// that are being retained per spec.RevisionHistoryLimit.
+func revisionHistory(ctx context.Context, d *appsv1.Deployment, objectStore store.Store) (*component.FlexLayout, error) {
+	replicaSets, err := ControlledDeploymentReplicaSets(ctx, d, objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHash := d.Spec.Template.Labels["pod-template-hash"]
+
+	cols := component.NewTableCols("Revision", "Name", "Pod Template Hash", "Images", "Current", "Age")
+	table := component.NewTable("Revision History", cols)
+
+	for _, rs := range replicaSets {
+		revision := rs.Annotations["deployment.kubernetes.io/revision"]
+		hash := rs.Spec.Template.Labels["pod-template-hash"]
+
+		var images []string
+		for _, c := range rs.Spec.Template.Spec.Containers {
+			images = append(images, c.Image)
+		}
+
+		current := "old"
+		if hash == currentHash {
+			current = "current"
+		}
+
+		table.Add(component.TableRow{
+			"Revision":          component.NewText(revision),
+			"Name":              component.NewText(rs.Name),
+			"Pod Template Hash": component.NewText(hash),
+			"Images":            component.NewText(fmt.Sprintf("%v", images)),
+			"Current":           component.NewText(current),
+			"Age":               component.NewTimestamp(rs.CreationTimestamp.Time),
+		})
+	}
+
+	layout := component.NewFlexLayout("Revision History")
+	layout.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: table},
+	})
+
+	return layout, nil
+}
+
+// ControlledDeploymentReplicaSets lists the ReplicaSets in the Deployment's
+// namespace matching its selector, filters them down to the ones owned by it
+// (via ownerReferences), and sorts newest revision first.
+func ControlledDeploymentReplicaSets(ctx context.Context, d *appsv1.Deployment, objectStore store.Store) ([]*appsv1.ReplicaSet, error) {
+	var selector labels.Set
+	if d.Spec.Selector != nil {
+		selector = d.Spec.Selector.MatchLabels
+	}
+
+	key := store.Key{
+		Namespace:  d.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &selector,
+	}
+
+	objects, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list replica sets")
+	}
+
+	var replicaSets []*appsv1.ReplicaSet
+	for i := range objects {
+		rs, err := convertToReplicaSet(objects[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if !isOwnedBy(rs.OwnerReferences, d.UID) {
+			continue
+		}
+
+		replicaSets = append(replicaSets, rs)
+	}
+
+	sort.Slice(replicaSets, func(i, j int) bool {
+		ri, _ := strconv.Atoi(replicaSets[i].Annotations["deployment.kubernetes.io/revision"])
+		rj, _ := strconv.Atoi(replicaSets[j].Annotations["deployment.kubernetes.io/revision"])
+		return ri > rj
+	})
+
+	return replicaSets, nil
+}
+
+func convertToReplicaSet(object *unstructured.Unstructured) (*appsv1.ReplicaSet, error) {
+	rs := &appsv1.ReplicaSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, rs); err != nil {
+		return nil, errors.Wrap(err, "convert replica set")
+	}
+	return rs, nil
+}
+
+func isOwnedBy(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// podColsWithOutLabels are the columns rendered for a deployment's pod list.
+var podColsWithOutLabels = component.NewTableCols("Name", "Age", "Ready", "Restarts", "Phase", "Node")
+
+// deploymentPods prints pods for a deployment: a flat "Pods" table, followed
+// by the same pods grouped by owning ReplicaSet so an in-progress rolling
+// update's new and old generations are visible.
+func deploymentPods(ctx context.Context, deployment *appsv1.Deployment, opts Options) (component.Component, error) {
+	if deployment == nil {
+		return nil, errors.New("deployment is nil")
+	}
+
+	objectStore := opts.DashConfig.ObjectStore()
+
+	selector := labels.Set(deployment.Spec.Template.Labels)
+
+	pods, err := listPodsBySelector(ctx, deployment.Namespace, selector, objectStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods for deployment")
+	}
+
+	SortPodsByActiveness(pods)
+
+	table := component.NewTableWithRows("Pods", podColsWithOutLabels, nil)
+
+	for _, pod := range pods {
+		row, err := podTableRow(pod, opts)
+		if err != nil {
+			return nil, err
+		}
+		table.Add(row)
+	}
+
+	byRevision, err := deploymentReplicaSetPods(ctx, deployment, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := component.NewFlexLayout("Pods")
+	layout.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: table},
+	})
+	layout.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: byRevision},
+	})
+
+	return layout, nil
+}
+
+// listPodsBySelector lists pods in a namespace matching a label selector.
+func listPodsBySelector(ctx context.Context, namespace string, selector labels.Set, objectStore store.Store) ([]*corev1.Pod, error) {
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &selector,
+	}
+
+	objects, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []*corev1.Pod
+	for i := range objects {
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(objects[i].Object, pod); err != nil {
+			return nil, errors.Wrap(err, "convert pod")
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+// podTableRow renders a single pod table row for the Name/Age/Ready/
+// Restarts/Phase/Node column set used by deploymentPods.
+func podTableRow(pod *corev1.Pod, opts Options) (component.TableRow, error) {
+	row := component.TableRow{}
+
+	nameLink, err := opts.Link.ForObject(pod, pod.Name)
+	if err != nil {
+		return nil, err
+	}
+	row["Name"] = nameLink
+
+	row["Age"] = component.NewTimestamp(pod.CreationTimestamp.Time)
+	row["Ready"] = component.NewText(podReadyText(pod))
+	row["Restarts"] = component.NewText(fmt.Sprintf("%d", podRestartCount(pod)))
+	row["Phase"] = component.NewText(string(pod.Status.Phase))
+	row["Node"] = component.NewText(pod.Spec.NodeName)
+
+	return row, nil
+}
+
+func podReadyText(pod *corev1.Pod) string {
+	var ready, total int
+	for _, cs := range pod.Status.ContainerStatuses {
+		total++
+		if cs.Ready {
+			ready++
+		}
+	}
+	return fmt.Sprintf("%d/%d", ready, total)
+}
+
+func podRestartCount(pod *corev1.Pod) int32 {
+	var count int32
+	for _, cs := range pod.Status.ContainerStatuses {
+		count += cs.RestartCount
+	}
+	return count
+}
+
+func deploymentStrategyChoices(selected appsv1.DeploymentStrategyType) []component.InputChoice {
+	types := []appsv1.DeploymentStrategyType{
+		appsv1.RollingUpdateDeploymentStrategyType,
+		appsv1.RecreateDeploymentStrategyType,
+	}
+
+	choices := make([]component.InputChoice, 0, len(types))
+	for _, t := range types {
+		choices = append(choices, component.InputChoice{
+			Label:   string(t),
+			Value:   string(t),
+			Checked: t == selected,
+		})
+	}
+	return choices
+}
+
+// editDeploymentAction returns the set of actions available from the
+// Deployment's configuration summary: replica count and rollout strategy,
+// including MaxSurge/MaxUnavailable when the strategy is RollingUpdate.
+func editDeploymentAction(d *appsv1.Deployment) []component.Action {
+	replicas := fmt.Sprintf("%d", int32(1))
+	if d.Spec.Replicas != nil {
+		replicas = fmt.Sprintf("%d", *d.Spec.Replicas)
+	}
+
+	strategyType := d.Spec.Strategy.Type
+	if strategyType == "" {
+		strategyType = appsv1.RollingUpdateDeploymentStrategyType
+	}
+
+	var maxSurge, maxUnavailable string
+	if ru := d.Spec.Strategy.RollingUpdate; ru != nil {
+		if ru.MaxSurge != nil {
+			maxSurge = ru.MaxSurge.String()
+		}
+		if ru.MaxUnavailable != nil {
+			maxUnavailable = ru.MaxUnavailable.String()
+		}
+	}
+
+	fields := append([]component.FormField{
+		component.NewFormFieldNumber("Replicas", "replicas", replicas),
+		component.NewFormFieldDropDown("Strategy Type", "strategyType", deploymentStrategyChoices(strategyType)),
+		component.NewFormFieldText("Max Surge", "maxSurge", maxSurge),
+		component.NewFormFieldText("Max Unavailable", "maxUnavailable", maxUnavailable),
+	}, deploymentHiddenFields(d, "deployment/configuration")...)
+
+	return []component.Action{
+		{
+			Name:  "Edit",
+			Title: "Deployment Editor",
+			Form: component.Form{
+				Fields: fields,
+			},
+		},
+	}
+}
+
+func deploymentHiddenFields(d *appsv1.Deployment, action string) []component.FormField {
+	gvk := d.GroupVersionKind()
+
+	return []component.FormField{
+		component.NewFormFieldHidden("group", gvk.Group),
+		component.NewFormFieldHidden("version", gvk.Version),
+		component.NewFormFieldHidden("kind", gvk.Kind),
+		component.NewFormFieldHidden("name", d.Name),
+		component.NewFormFieldHidden("namespace", d.Namespace),
+		component.NewFormFieldHidden("action", action),
+	}
+}
+
+// pauseResumeDeploymentAction returns the "Pause" or "Resume" action,
+// mirroring `kubectl rollout pause/resume`, depending on whether the
+// Deployment is currently paused.
+func pauseResumeDeploymentAction(d *appsv1.Deployment) []component.Action {
+	name, title, action := "Pause", "Pause Rollout", "deployment/pause"
+	if d.Spec.Paused {
+		name, title, action = "Resume", "Resume Rollout", "deployment/resume"
+	}
+
+	return []component.Action{
+		{
+			Name:  name,
+			Title: title,
+			Form: component.Form{
+				Fields: deploymentHiddenFields(d, action),
+			},
+		},
+	}
+}
+
+// restartDeploymentAction returns the "Restart" action, mirroring
+// `kubectl rollout restart`, which patches the pod template with a
+// restartedAt annotation to force a new rollout.
+func restartDeploymentAction(d *appsv1.Deployment) []component.Action {
+	return []component.Action{
+		{
+			Name:  "Restart",
+			Title: "Restart Rollout",
+			Form: component.Form{
+				Fields: deploymentHiddenFields(d, "deployment/restart"),
+			},
+		},
+	}
+}
+
+// RollbackAction returns the "Rollback to revision..." action for a
+// Deployment, with a dropdown populated from the revisions of its
+// controlled ReplicaSets, mirroring `kubectl rollout undo --to-revision`.
+// The current revision is excluded from the choices, and the action is
+// omitted entirely when no older revision remains, matching
+// `kubectl rollout undo` refusing to run with no history.
+func RollbackAction(ctx context.Context, d *appsv1.Deployment, objectStore store.Store) (*component.Action, error) {
+	replicaSets, err := ControlledDeploymentReplicaSets(ctx, d, objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHash := d.Spec.Template.Labels["pod-template-hash"]
+
+	var choices []component.InputChoice
+	for _, rs := range replicaSets {
+		hash := rs.Spec.Template.Labels["pod-template-hash"]
+		if hash == currentHash {
+			continue
+		}
+
+		revision := rs.Annotations["deployment.kubernetes.io/revision"]
+		choices = append(choices, component.InputChoice{
+			Label: fmt.Sprintf("Revision %s (%s)", revision, hash),
+			Value: revision,
+		})
+	}
+
+	if len(choices) == 0 {
+		return nil, nil
+	}
+
+	fields := append([]component.FormField{
+		component.NewFormFieldDropDown("Revision", "revision", choices),
+	}, deploymentHiddenFields(d, "deployment/rollback")...)
+
+	return &component.Action{
+		Name:  "Rollback",
+		Title: "Rollback to Revision",
+		Form: component.Form{
+			Fields: fields,
+		},
+	}, nil
+}
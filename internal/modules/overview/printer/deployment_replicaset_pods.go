@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// deploymentReplicaSetPods groups a Deployment's pods by their owning
+// ReplicaSet, labeled with the ReplicaSet's revision and whether it is the
+// "current" ReplicaSet (matching the Deployment's pod-template hash) or an
+// "old" one being scaled down. This makes an in-progress rolling update
+// visible in a way the flat pod list from deploymentPods cannot.
+func deploymentReplicaSetPods(ctx context.Context, deployment *appsv1.Deployment, opts Options) (*component.FlexLayout, error) {
+	if deployment == nil {
+		return nil, errors.New("deployment is nil")
+	}
+
+	objectStore := opts.DashConfig.ObjectStore()
+
+	replicaSets, err := ControlledDeploymentReplicaSets(ctx, deployment, objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	currentHash := deployment.Spec.Template.Labels["pod-template-hash"]
+
+	layout := component.NewFlexLayout("Pods by Revision")
+
+	for _, rs := range replicaSets {
+		table, err := replicaSetPodsTable(ctx, rs, currentHash, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		layout.AddSections(component.FlexLayoutSection{
+			{Width: component.WidthFull, View: table},
+		})
+	}
+
+	return layout, nil
+}
+
+// replicaSetPodsTable builds a single revision's pod table, headed by its
+// revision, replica counts, and image list.
+func replicaSetPodsTable(ctx context.Context, rs *appsv1.ReplicaSet, currentHash string, opts Options) (*component.Table, error) {
+	objectStore := opts.DashConfig.ObjectStore()
+
+	selector := labels.Set(rs.Spec.Template.Labels)
+	pods, err := listPodsBySelector(ctx, rs.Namespace, selector, objectStore)
+	if err != nil {
+		return nil, err
+	}
+
+	ownedPods := filterPodsOwnedBy(pods, rs.UID)
+
+	SortPodsByActiveness(ownedPods)
+
+	revision := rs.Annotations["deployment.kubernetes.io/revision"]
+	hash := rs.Spec.Template.Labels["pod-template-hash"]
+
+	current := "old"
+	if hash == currentHash {
+		current = "current"
+	}
+
+	var images []string
+	for _, c := range rs.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	var desired int32 = 1
+	if rs.Spec.Replicas != nil {
+		desired = *rs.Spec.Replicas
+	}
+
+	title := fmt.Sprintf("Revision %s (%s) - %d/%d ready - %v",
+		revision, current, rs.Status.ReadyReplicas, desired, images)
+
+	table := component.NewTableWithRows(title, podColsWithOutLabels, nil)
+	for _, pod := range ownedPods {
+		row, err := podTableRow(pod, opts)
+		if err != nil {
+			return nil, err
+		}
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+// filterPodsOwnedBy returns the pods owned by the given UID, per
+// ownerReferences.
+func filterPodsOwnedBy(pods []*corev1.Pod, uid types.UID) []*corev1.Pod {
+	var owned []*corev1.Pod
+	for _, pod := range pods {
+		for _, ref := range pod.OwnerReferences {
+			if ref.UID == uid {
+				owned = append(owned, pod)
+				break
+			}
+		}
+	}
+	return owned
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+)
+
+func Test_deploymentReplicaSetPods(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	deploymentUID := types.UID("deployment-uid")
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = deploymentUID
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "deployment"}}
+	deployment.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	rs := testutil.CreateReplicaSet("deployment-abc123")
+	rsUID := types.UID("rs-uid")
+	rs.UID = rsUID
+	rs.OwnerReferences = []metav1.OwnerReference{{UID: deploymentUID}}
+	rs.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	rs.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	pod := testutil.CreatePod("pod")
+	pod.OwnerReferences = []metav1.OwnerReference{{UID: rsUID}}
+
+	rsSelector := labels.Set(deployment.Spec.Selector.MatchLabels)
+	rsKey := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &rsSelector,
+	}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), rsKey).
+		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, rs)}, nil)
+
+	podSelector := labels.Set(rs.Spec.Template.Labels)
+	podKey := store.Key{
+		Namespace:  rs.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &podSelector,
+	}
+	tpo.PathForObject(pod, pod.Name, "/pod")
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), podKey).
+		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, pod)}, nil)
+
+	ctx := context.Background()
+	got, err := deploymentReplicaSetPods(ctx, deployment, printOptions)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
+
+func Test_deploymentReplicaSetPods_nilDeployment(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	_, err := deploymentReplicaSetPods(context.Background(), nil, printOptions)
+	require.Error(t, err)
+}
+
+func Test_filterPodsOwnedBy(t *testing.T) {
+	uid := types.UID("owner-uid")
+
+	owned := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "owned",
+			OwnerReferences: []metav1.OwnerReference{{UID: uid}},
+		},
+	}
+	notOwned := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-owned"},
+	}
+
+	got := filterPodsOwnedBy([]*corev1.Pod{owned, notOwned}, uid)
+
+	require.Len(t, got, 1)
+	require.Equal(t, "owned", got[0].Name)
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
 	"github.com/golang/mock/gomock"
@@ -112,6 +113,21 @@ func Test_DeploymentListHandler(t *testing.T) {
 }
 
 func Test_deploymentConfiguration(t *testing.T) {
+	expectedRolloutLayout := component.NewFlexLayout("Rollout Status")
+	expectedRolloutLayout.AddSections(component.FlexLayoutSection{
+		{
+			Width: component.WidthHalf,
+			View: component.NewSummary("Rollout Status", component.SummarySection{
+				Header:  "Status",
+				Content: component.NewText("waiting for rollout to finish: 0 of 3 new replicas have been updated"),
+			}),
+		},
+		{
+			Width: component.WidthHalf,
+			View:  component.NewTable("Conditions", component.NewTableCols("Type", "Status", "Reason", "Message", "Last Transition")),
+		},
+	})
+
 	cases := []struct {
 		name       string
 		deployment *appsv1.Deployment
@@ -151,6 +167,10 @@ func Test_deploymentConfiguration(t *testing.T) {
 					Header:  "Replicas",
 					Content: component.NewText("3"),
 				},
+				{
+					Header:  "Rollout Status",
+					Content: expectedRolloutLayout,
+				},
 			}...),
 		},
 		{
@@ -162,7 +182,7 @@ func Test_deploymentConfiguration(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			dc := NewDeploymentConfiguration(tc.deployment)
+			dc := NewDeploymentConfiguration(context.Background(), tc.deployment, nil)
 			dc.actionGenerators = []actionGeneratorFunction{}
 
 			summary, err := dc.Create()
@@ -177,6 +197,48 @@ func Test_deploymentConfiguration(t *testing.T) {
 	}
 }
 
+func Test_deploymentConfiguration_Create_withRollbackAction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	deploymentUID := types.UID("deployment-uid")
+	deployment := validDeployment.DeepCopy()
+	deployment.UID = deploymentUID
+
+	rs := testutil.CreateReplicaSet("deployment-abc123")
+	rs.OwnerReferences = []metav1.OwnerReference{{UID: deploymentUID}}
+	rs.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	rs.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	selector := labels.Set(deployment.Spec.Selector.MatchLabels)
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &selector,
+	}
+	// Create lists the ReplicaSets once for the revision history and once for
+	// the rollback action.
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, rs)}, nil).
+		Times(2)
+
+	dc := NewDeploymentConfiguration(context.Background(), deployment, tpo.objectStore)
+
+	summary, err := dc.Create()
+	require.NoError(t, err)
+
+	var names []string
+	for _, action := range summary.Actions {
+		names = append(names, action.Name)
+	}
+
+	assert.Contains(t, names, "Rollback")
+}
+
 var (
 	rhl             int32 = 5
 	validDeployment       = &appsv1.Deployment{
@@ -274,6 +336,7 @@ func Test_deploymentPods(t *testing.T) {
 
 	deployment := testutil.CreateDeployment("deployment")
 	deployment.Spec.Template.ObjectMeta.Labels = podLabels
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: podLabels}
 
 	now := time.Unix(1559734098, 0)
 	pod := testutil.CreatePod("pod")
@@ -292,12 +355,23 @@ func Test_deploymentPods(t *testing.T) {
 		List(gomock.Any(), key).
 		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, pod)}, nil)
 
+	rsSelector := labels.Set(podLabels)
+	rsKey := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &rsSelector,
+	}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), rsKey).
+		Return(nil, nil)
+
 	ctx := context.Background()
 
 	got, err := deploymentPods(ctx, deployment, printOptions)
 	require.NoError(t, err)
 
-	expected := component.NewTableWithRows("Pods", podColsWithOutLabels, []component.TableRow{
+	table := component.NewTableWithRows("Pods", podColsWithOutLabels, []component.TableRow{
 		{
 			"Name":     component.NewLink("", pod.Name, "/pod"),
 			"Age":      component.NewTimestamp(now),
@@ -308,12 +382,29 @@ func Test_deploymentPods(t *testing.T) {
 		},
 	})
 
+	byRevision := component.NewFlexLayout("Pods by Revision")
+
+	expected := component.NewFlexLayout("Pods")
+	expected.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: table},
+	})
+	expected.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthFull, View: byRevision},
+	})
+
 	assertComponentEqual(t, expected, got)
 }
 
 func Test_editDeploymentAction(t *testing.T) {
 	deployment := testutil.CreateDeployment("deployment")
 	deployment.Spec.Replicas = pointer.Int32Ptr(3)
+	deployment.Spec.Strategy = appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxSurge:       &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+			MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+		},
+	}
 
 	actions := editDeploymentAction(deployment)
 	assert.Len(t, actions, 1)
@@ -328,6 +419,9 @@ func Test_editDeploymentAction(t *testing.T) {
 		Form: component.Form{
 			Fields: []component.FormField{
 				component.NewFormFieldNumber("Replicas", "replicas", "3"),
+				component.NewFormFieldDropDown("Strategy Type", "strategyType", deploymentStrategyChoices(appsv1.RollingUpdateDeploymentStrategyType)),
+				component.NewFormFieldText("Max Surge", "maxSurge", "25%"),
+				component.NewFormFieldText("Max Unavailable", "maxUnavailable", "25%"),
 				component.NewFormFieldHidden("group", gvk.Group),
 				component.NewFormFieldHidden("version", gvk.Version),
 				component.NewFormFieldHidden("kind", gvk.Kind),
@@ -340,3 +434,374 @@ func Test_editDeploymentAction(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_deploymentStrategyChoices(t *testing.T) {
+	choices := deploymentStrategyChoices(appsv1.RecreateDeploymentStrategyType)
+
+	expected := []component.InputChoice{
+		{Label: "RollingUpdate", Value: "RollingUpdate", Checked: false},
+		{Label: "Recreate", Value: "Recreate", Checked: true},
+	}
+
+	assert.Equal(t, expected, choices)
+}
+
+func Test_pauseResumeDeploymentAction(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	gvk := deployment.GroupVersionKind()
+
+	hiddenFields := []component.FormField{
+		component.NewFormFieldHidden("group", gvk.Group),
+		component.NewFormFieldHidden("version", gvk.Version),
+		component.NewFormFieldHidden("kind", gvk.Kind),
+		component.NewFormFieldHidden("name", deployment.Name),
+		component.NewFormFieldHidden("namespace", deployment.Namespace),
+	}
+
+	t.Run("not paused", func(t *testing.T) {
+		actions := pauseResumeDeploymentAction(deployment)
+		require.Len(t, actions, 1)
+
+		expected := component.Action{
+			Name:  "Pause",
+			Title: "Pause Rollout",
+			Form: component.Form{
+				Fields: append(append([]component.FormField{}, hiddenFields...),
+					component.NewFormFieldHidden("action", "deployment/pause")),
+			},
+		}
+		assert.Equal(t, expected, actions[0])
+	})
+
+	t.Run("paused", func(t *testing.T) {
+		paused := deployment.DeepCopy()
+		paused.Spec.Paused = true
+
+		actions := pauseResumeDeploymentAction(paused)
+		require.Len(t, actions, 1)
+
+		expected := component.Action{
+			Name:  "Resume",
+			Title: "Resume Rollout",
+			Form: component.Form{
+				Fields: append(append([]component.FormField{}, hiddenFields...),
+					component.NewFormFieldHidden("action", "deployment/resume")),
+			},
+		}
+		assert.Equal(t, expected, actions[0])
+	})
+}
+
+func Test_restartDeploymentAction(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	gvk := deployment.GroupVersionKind()
+
+	actions := restartDeploymentAction(deployment)
+	require.Len(t, actions, 1)
+
+	expected := component.Action{
+		Name:  "Restart",
+		Title: "Restart Rollout",
+		Form: component.Form{
+			Fields: []component.FormField{
+				component.NewFormFieldHidden("group", gvk.Group),
+				component.NewFormFieldHidden("version", gvk.Version),
+				component.NewFormFieldHidden("kind", gvk.Kind),
+				component.NewFormFieldHidden("name", deployment.Name),
+				component.NewFormFieldHidden("namespace", deployment.Namespace),
+				component.NewFormFieldHidden("action", "deployment/restart"),
+			},
+		},
+	}
+	assert.Equal(t, expected, actions[0])
+}
+
+func Test_RollbackAction(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	deploymentUID := types.UID("deployment-uid")
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = deploymentUID
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "deployment"}}
+	deployment.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "def456"}
+
+	rs := testutil.CreateReplicaSet("deployment-abc123")
+	rs.OwnerReferences = []metav1.OwnerReference{{UID: deploymentUID}}
+	rs.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	rs.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	selector := labels.Set(deployment.Spec.Selector.MatchLabels)
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &selector,
+	}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, rs)}, nil)
+
+	ctx := context.Background()
+	action, err := RollbackAction(ctx, deployment, tpo.objectStore)
+	require.NoError(t, err)
+	require.NotNil(t, action)
+	assert.Equal(t, "Rollback", action.Name)
+}
+
+func Test_RollbackAction_noOlderRevision(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	deploymentUID := types.UID("deployment-uid")
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = deploymentUID
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "deployment"}}
+	deployment.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	rs := testutil.CreateReplicaSet("deployment-abc123")
+	rs.OwnerReferences = []metav1.OwnerReference{{UID: deploymentUID}}
+	rs.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	rs.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	selector := labels.Set(deployment.Spec.Selector.MatchLabels)
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &selector,
+	}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, rs)}, nil)
+
+	ctx := context.Background()
+	action, err := RollbackAction(ctx, deployment, tpo.objectStore)
+	require.NoError(t, err)
+	assert.Nil(t, action)
+}
+
+func Test_rolloutStatus(t *testing.T) {
+	cases := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		expected   string
+	}{
+		{
+			name: "successfully rolled out",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+				Spec:       appsv1.DeploymentSpec{Replicas: conversion.PtrInt32(3)},
+				Status: appsv1.DeploymentStatus{
+					Replicas:          3,
+					UpdatedReplicas:   3,
+					AvailableReplicas: 3,
+				},
+			},
+			expected: `deployment "deployment" successfully rolled out`,
+		},
+		{
+			name: "waiting for new replicas to be updated",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+				Spec:       appsv1.DeploymentSpec{Replicas: conversion.PtrInt32(3)},
+				Status: appsv1.DeploymentStatus{
+					Replicas:        3,
+					UpdatedReplicas: 1,
+				},
+			},
+			expected: "waiting for rollout to finish: 1 of 3 new replicas have been updated",
+		},
+		{
+			name: "waiting for old replicas to terminate",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+				Spec:       appsv1.DeploymentSpec{Replicas: conversion.PtrInt32(3)},
+				Status: appsv1.DeploymentStatus{
+					Replicas:        4,
+					UpdatedReplicas: 3,
+				},
+			},
+			expected: "waiting for rollout to finish: 1 old replicas are pending termination",
+		},
+		{
+			name: "exceeded progress deadline",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+				Spec:       appsv1.DeploymentSpec{Replicas: conversion.PtrInt32(3)},
+				Status: appsv1.DeploymentStatus{
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			expected: `deployment "deployment" exceeded its progress deadline`,
+		},
+		{
+			name: "spec update not yet observed",
+			deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "deployment", Generation: 2},
+				Spec:       appsv1.DeploymentSpec{Replicas: conversion.PtrInt32(3)},
+				Status: appsv1.DeploymentStatus{
+					ObservedGeneration: 1,
+					Replicas:           3,
+					UpdatedReplicas:    3,
+					AvailableReplicas:  3,
+				},
+			},
+			expected: "Waiting for deployment spec update to be observed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, rolloutStatus(tc.deployment))
+		})
+	}
+}
+
+func Test_DeploymentRolloutStatus(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas:                conversion.PtrInt32(3),
+			ProgressDeadlineSeconds: pointer.Int32Ptr(600),
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:        3,
+			UpdatedReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:               appsv1.DeploymentAvailable,
+					Status:             corev1.ConditionTrue,
+					Reason:             "MinimumReplicasAvailable",
+					Message:            "Deployment has minimum availability.",
+					LastTransitionTime: metav1.Time{Time: now},
+				},
+			},
+		},
+	}
+
+	got, err := DeploymentRolloutStatus(deployment)
+	require.NoError(t, err)
+
+	expectedConditions := component.NewTable("Conditions", component.NewTableCols("Type", "Status", "Reason", "Message", "Last Transition"))
+	expectedConditions.Add(component.TableRow{
+		"Type":            component.NewText("Available"),
+		"Status":          component.NewText("True"),
+		"Reason":          component.NewText("MinimumReplicasAvailable"),
+		"Message":         component.NewText("Deployment has minimum availability."),
+		"Last Transition": component.NewTimestamp(now),
+	})
+
+	expectedSummary := component.NewSummary("Rollout Status", []component.SummarySection{
+		{
+			Header:  "Status",
+			Content: component.NewText("waiting for rollout to finish: 1 of 3 new replicas have been updated"),
+		},
+		{
+			Header:  "Progress Deadline Seconds",
+			Content: component.NewText("600"),
+		},
+	}...)
+
+	expected := component.NewFlexLayout("Rollout Status")
+	expected.AddSections(component.FlexLayoutSection{
+		{Width: component.WidthHalf, View: expectedSummary},
+		{Width: component.WidthHalf, View: expectedConditions},
+	})
+
+	assertComponentEqual(t, expected, got)
+}
+
+func Test_DeploymentRolloutStatus_nilDeployment(t *testing.T) {
+	_, err := DeploymentRolloutStatus(nil)
+	require.Error(t, err)
+}
+
+func Test_deploymentConditions(t *testing.T) {
+	now := time.Unix(1547211430, 0)
+
+	deployment := &appsv1.Deployment{
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:               appsv1.DeploymentAvailable,
+					Status:             corev1.ConditionTrue,
+					Reason:             "MinimumReplicasAvailable",
+					Message:            "Deployment has minimum availability.",
+					LastTransitionTime: metav1.Time{Time: now},
+				},
+			},
+		},
+	}
+
+	got := deploymentConditions(deployment)
+
+	cols := component.NewTableCols("Type", "Status", "Reason", "Message", "Last Transition")
+	expected := component.NewTable("Conditions", cols)
+	expected.Add(component.TableRow{
+		"Type":            component.NewText("Available"),
+		"Status":          component.NewText("True"),
+		"Reason":          component.NewText("MinimumReplicasAvailable"),
+		"Message":         component.NewText("Deployment has minimum availability."),
+		"Last Transition": component.NewTimestamp(now),
+	})
+
+	assertComponentEqual(t, expected, got)
+}
+
+func Test_revisionHistory(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	deploymentUID := types.UID("deployment-uid")
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.UID = deploymentUID
+	deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "deployment"}}
+	deployment.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+
+	now := time.Unix(1547211430, 0)
+
+	current := testutil.CreateReplicaSet("deployment-abc123")
+	current.CreationTimestamp = metav1.Time{Time: now}
+	current.OwnerReferences = []metav1.OwnerReference{{UID: deploymentUID}}
+	current.Annotations = map[string]string{"deployment.kubernetes.io/revision": "2"}
+	current.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "abc123"}
+	current.Spec.Template.Spec.Containers = []corev1.Container{{Name: "nginx", Image: "nginx:1.16"}}
+
+	old := testutil.CreateReplicaSet("deployment-def456")
+	old.CreationTimestamp = metav1.Time{Time: now}
+	old.OwnerReferences = []metav1.OwnerReference{{UID: deploymentUID}}
+	old.Annotations = map[string]string{"deployment.kubernetes.io/revision": "1"}
+	old.Spec.Template.ObjectMeta.Labels = map[string]string{"pod-template-hash": "def456"}
+	old.Spec.Template.Spec.Containers = []corev1.Container{{Name: "nginx", Image: "nginx:1.15"}}
+
+	selector := labels.Set(deployment.Spec.Selector.MatchLabels)
+	key := store.Key{
+		Namespace:  deployment.Namespace,
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+		Selector:   &selector,
+	}
+	tpo.objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return([]*unstructured.Unstructured{
+			testutil.ToUnstructured(t, current),
+			testutil.ToUnstructured(t, old),
+		}, nil)
+
+	ctx := context.Background()
+	got, err := revisionHistory(ctx, deployment, tpo.objectStore)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+// podsForSelector prints the pods in namespace matching selector, sorted by
+// SortPodsByActiveness so unassigned, pending and not-ready pods sort first
+// and running, ready pods sort last, matching deploymentPods.
+func podsForSelector(ctx context.Context, namespace string, selector labels.Set, opts Options) (component.Component, error) {
+	objectStore := opts.DashConfig.ObjectStore()
+
+	pods, err := listPodsBySelector(ctx, namespace, selector, objectStore)
+	if err != nil {
+		return nil, errors.Wrap(err, "list pods for selector")
+	}
+
+	SortPodsByActiveness(pods)
+
+	table := component.NewTableWithRows("Pods", podColsWithOutLabels, nil)
+
+	for _, pod := range pods {
+		row, err := podTableRow(pod, opts)
+		if err != nil {
+			return nil, err
+		}
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+// replicaSetPods prints pods for a replica set, sorted so running, ready
+// pods sort last, matching deploymentPods.
+func replicaSetPods(ctx context.Context, replicaSet *appsv1.ReplicaSet, opts Options) (component.Component, error) {
+	if replicaSet == nil {
+		return nil, errors.New("replica set is nil")
+	}
+
+	selector := labels.Set(replicaSet.Spec.Template.Labels)
+	return podsForSelector(ctx, replicaSet.Namespace, selector, opts)
+}
+
+// statefulSetPods prints pods for a stateful set, sorted so running, ready
+// pods sort last, matching deploymentPods.
+func statefulSetPods(ctx context.Context, statefulSet *appsv1.StatefulSet, opts Options) (component.Component, error) {
+	if statefulSet == nil {
+		return nil, errors.New("stateful set is nil")
+	}
+
+	selector := labels.Set(statefulSet.Spec.Template.Labels)
+	return podsForSelector(ctx, statefulSet.Namespace, selector, opts)
+}
+
+// daemonSetPods prints pods for a daemon set, sorted so running, ready
+// pods sort last, matching deploymentPods.
+func daemonSetPods(ctx context.Context, daemonSet *appsv1.DaemonSet, opts Options) (component.Component, error) {
+	if daemonSet == nil {
+		return nil, errors.New("daemon set is nil")
+	}
+
+	selector := labels.Set(daemonSet.Spec.Template.Labels)
+	return podsForSelector(ctx, daemonSet.Namespace, selector, opts)
+}
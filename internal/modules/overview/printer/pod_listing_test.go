@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware/octant/internal/testutil"
+	"github.com/vmware/octant/pkg/store"
+	"github.com/vmware/octant/pkg/view/component"
+)
+
+func Test_podListing(t *testing.T) {
+	podLabels := map[string]string{"foo": "bar"}
+
+	replicaSet := testutil.CreateReplicaSet("replica-set")
+	replicaSet.Spec.Template.ObjectMeta.Labels = podLabels
+
+	statefulSet := testutil.CreateStatefulSet("stateful-set")
+	statefulSet.Spec.Template.ObjectMeta.Labels = podLabels
+
+	daemonSet := testutil.CreateDaemonSet("daemon-set")
+	daemonSet.Spec.Template.ObjectMeta.Labels = podLabels
+
+	tests := []struct {
+		name string
+		fn   func(ctx context.Context, opts Options) (component.Component, error)
+	}{
+		{
+			name: "replica set",
+			fn: func(ctx context.Context, opts Options) (component.Component, error) {
+				return replicaSetPods(ctx, replicaSet, opts)
+			},
+		},
+		{
+			name: "stateful set",
+			fn: func(ctx context.Context, opts Options) (component.Component, error) {
+				return statefulSetPods(ctx, statefulSet, opts)
+			},
+		},
+		{
+			name: "daemon set",
+			fn: func(ctx context.Context, opts Options) (component.Component, error) {
+				return daemonSetPods(ctx, daemonSet, opts)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			now := time.Unix(1559734098, 0)
+			pod := testutil.CreatePod("pod")
+			pod.ObjectMeta.CreationTimestamp = metav1.Time{Time: now}
+
+			tpo.PathForObject(pod, pod.Name, "/pod")
+
+			selector := labels.Set(podLabels)
+			key := store.Key{
+				Namespace:  "namespace",
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Selector:   &selector,
+			}
+			tpo.objectStore.EXPECT().
+				List(gomock.Any(), key).
+				Return([]*unstructured.Unstructured{testutil.ToUnstructured(t, pod)}, nil)
+
+			ctx := context.Background()
+
+			got, err := test.fn(ctx, printOptions)
+			require.NoError(t, err)
+
+			expected := component.NewTableWithRows("Pods", podColsWithOutLabels, []component.TableRow{
+				{
+					"Name":     component.NewLink("", pod.Name, "/pod"),
+					"Age":      component.NewTimestamp(now),
+					"Ready":    component.NewText("0/0"),
+					"Restarts": component.NewText("0"),
+					"Phase":    component.NewText(""),
+					"Node":     component.NewText(""),
+				},
+			})
+
+			assertComponentEqual(t, expected, got)
+		})
+	}
+}
+
+func Test_replicaSetPods_nilReplicaSet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	_, err := replicaSetPods(context.Background(), nil, printOptions)
+	require.Error(t, err)
+}
+
+func Test_statefulSetPods_nilStatefulSet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	_, err := statefulSetPods(context.Background(), nil, printOptions)
+	require.Error(t, err)
+}
+
+func Test_daemonSetPods_nilDaemonSet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	_, err := daemonSetPods(context.Background(), nil, printOptions)
+	require.Error(t, err)
+}
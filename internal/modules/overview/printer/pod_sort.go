@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SortPodsByActiveness orders pods using the same tiered comparator
+// Kubernetes controllers use to pick which pod to delete first during a
+// scale-down, so the most disposable pod (unassigned, pending or not ready)
+// sorts first and the most healthy/oldest pod sorts last. It is used by deploymentPods,
+// replicaSetPods, statefulSetPods, and daemonSetPods to give users a
+// predictable, meaningful pod ordering.
+func SortPodsByActiveness(pods []*corev1.Pod) {
+	sort.SliceStable(pods, func(i, j int) bool {
+		return activePodLess(pods[i], pods[j])
+	})
+}
+
+// activePodLess reports whether pod i should sort before pod j under the
+// tiered "active pod" comparator: unassigned before assigned, Pending
+// before Unknown before Running, not-ready before ready, more restarts
+// before fewer, more recently ready before less recently, newer before
+// older, and finally name as a tiebreaker.
+func activePodLess(i, j *corev1.Pod) bool {
+	iAssigned := i.Spec.NodeName != ""
+	jAssigned := j.Spec.NodeName != ""
+	if iAssigned != jAssigned {
+		return !iAssigned
+	}
+
+	iPhase := podPhaseRank(i.Status.Phase)
+	jPhase := podPhaseRank(j.Status.Phase)
+	if iPhase != jPhase {
+		return iPhase < jPhase
+	}
+
+	iReady := isPodReady(i)
+	jReady := isPodReady(j)
+	if iReady != jReady {
+		return !iReady
+	}
+
+	iRestarts := podRestartCount(i)
+	jRestarts := podRestartCount(j)
+	if iRestarts != jRestarts {
+		return iRestarts > jRestarts
+	}
+
+	iReadyTime, iHasReadyTime := podReadyTransitionTime(i)
+	jReadyTime, jHasReadyTime := podReadyTransitionTime(j)
+	if iHasReadyTime && jHasReadyTime && !iReadyTime.Equal(jReadyTime) {
+		return iReadyTime.After(jReadyTime)
+	}
+
+	iCreated := i.CreationTimestamp.Time
+	jCreated := j.CreationTimestamp.Time
+	if !iCreated.Equal(jCreated) {
+		return iCreated.After(jCreated)
+	}
+
+	return i.Name < j.Name
+}
+
+// podPhaseRank orders pod phases Pending < Unknown < Running < (others),
+// matching the tiers an "active pod" comparator cares about.
+func podPhaseRank(phase corev1.PodPhase) int {
+	switch phase {
+	case corev1.PodPending:
+		return 0
+	case corev1.PodUnknown:
+		return 1
+	case corev1.PodRunning:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podReadyTransitionTime(pod *corev1.Pod) (time.Time, bool) {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2019 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_SortPodsByActiveness(t *testing.T) {
+	now := time.Unix(1559734098, 0)
+
+	unassigned := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "unassigned"},
+	}
+
+	pending := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending"},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+
+	notReady := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-ready"},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+			},
+		},
+	}
+
+	readyManyRestarts := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ready-many-restarts", CreationTimestamp: metav1.Time{Time: now}},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.Time{Time: now}},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{RestartCount: 5},
+			},
+		},
+	}
+
+	readyFewRestarts := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ready-few-restarts", CreationTimestamp: metav1.Time{Time: now}},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.Time{Time: now}},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{RestartCount: 1},
+			},
+		},
+	}
+
+	pods := []*corev1.Pod{readyFewRestarts, readyManyRestarts, notReady, pending, unassigned}
+
+	SortPodsByActiveness(pods)
+
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+
+	assert.Equal(t, []string{
+		"unassigned",
+		"pending",
+		"not-ready",
+		"ready-many-restarts",
+		"ready-few-restarts",
+	}, names)
+}